@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleHealthz(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	rec := httptest.NewRecorder()
+
+	handleHealthz(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHandleHello(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/hello?name=Gladys", nil)
+	rec := httptest.NewRecorder()
+
+	handleHello(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var body map[string]string
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !strings.Contains(body["message"], "Gladys") {
+		t.Errorf("message = %q, want it to contain %q", body["message"], "Gladys")
+	}
+}
+
+func TestHandleHelloMissingName(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/hello", nil)
+	rec := httptest.NewRecorder()
+
+	handleHello(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleHellos(t *testing.T) {
+	body := strings.NewReader(`{"names":["Gladys","Samantha"]}`)
+	req := httptest.NewRequest(http.MethodPost, "/hellos", body)
+	rec := httptest.NewRecorder()
+
+	handleHellos(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp hellosResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(resp.Messages) != 2 {
+		t.Errorf("len(Messages) = %d, want 2", len(resp.Messages))
+	}
+}
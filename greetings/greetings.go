@@ -0,0 +1,163 @@
+// Package greetings provides functions for greeting people, including
+// batches of people and greetings in multiple languages.
+package greetings
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Supported language tags for SetLanguage.
+const (
+	English  = "en"
+	Spanish  = "es"
+	French   = "fr"
+	Japanese = "ja"
+)
+
+// mu guards the package's mutable state, since Hello and Hellos may be
+// called concurrently (e.g. from an HTTP server).
+var mu sync.RWMutex
+
+// currentLanguage is the BCP-47 tag used to look up greeting messages.
+var currentLanguage = English
+
+// formats maps a language tag to the greeting format strings available for
+// it. The default MessageSource picks one at random via randomFormat, as
+// does RandomFormat. Each format must contain
+// exactly one %v verb for the recipient's name.
+var formats = map[string][]string{
+	English: {
+		"Hi, %v. Welcome!",
+		"Great to see you, %v!",
+		"Hail, %v! Well met!",
+	},
+	Spanish:  {"Hola, %v. ¡Bienvenido!"},
+	French:   {"Salut, %v. Bienvenue!"},
+	Japanese: {"こんにちは、%v！"},
+}
+
+// randSource drives RandomFormat's selection. It defaults to a
+// time-seeded source and can be overridden with SetRandSource for
+// deterministic tests.
+var randSource rand.Source = rand.NewSource(time.Now().UnixNano())
+
+// verbRe matches printf-style verbs, including any flags, width, precision
+// or argument index (e.g. %5d, %-10v, %[1]v), and the escaped %% literal.
+var verbRe = regexp.MustCompile(`%[-+# 0-9.*\[\]]*[a-zA-Z%]`)
+
+// SetLanguage sets the language used by subsequent calls to Hello and
+// Hellos. It returns an error if tag is not a supported language.
+func SetLanguage(tag string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := formats[tag]; !ok {
+		return fmt.Errorf("greetings: unsupported language %q", tag)
+	}
+	currentLanguage = tag
+	return nil
+}
+
+// SetRandSource overrides the source of randomness used by RandomFormat,
+// allowing callers to seed it deterministically in tests.
+func SetRandSource(src rand.Source) {
+	mu.Lock()
+	defer mu.Unlock()
+	randSource = src
+}
+
+// RegisterFormat adds format to the pool of greeting formats used for the
+// current language. format must contain exactly one %v verb. It returns an
+// error otherwise.
+func RegisterFormat(format string) error {
+	if err := validateFormat(format); err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	formats[currentLanguage] = append(formats[currentLanguage], format)
+	return nil
+}
+
+// validateFormat reports an error unless format contains exactly one %v
+// verb and no other printf verbs.
+func validateFormat(format string) error {
+	var verbCount, otherCount int
+	for _, verb := range verbRe.FindAllString(format, -1) {
+		switch verb {
+		case "%%":
+			// escaped percent, not a verb
+		case "%v":
+			verbCount++
+		default:
+			otherCount++
+		}
+	}
+	if verbCount != 1 || otherCount != 0 {
+		return fmt.Errorf("greetings: format %q must contain exactly one %%v verb", format)
+	}
+	return nil
+}
+
+// RandomFormat returns a greeting format for the current language, chosen
+// uniformly at random from the registered formats.
+func RandomFormat() string {
+	mu.Lock()
+	defer mu.Unlock()
+	return randomFormat(currentLanguage)
+}
+
+// randomFormat returns a greeting format for locale, chosen uniformly at
+// random from its registered formats. Callers must hold mu.
+func randomFormat(locale string) string {
+	langFormats, ok := formats[locale]
+	if !ok || len(langFormats) == 0 {
+		langFormats = formats[English]
+	}
+	r := rand.New(randSource)
+	return langFormats[r.Intn(len(langFormats))]
+}
+
+// Hello returns a greeting for the named person in the current language,
+// using the configured MessageSource.
+func Hello(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("greetings: name cannot be empty")
+	}
+
+	mu.RLock()
+	locale, src := currentLanguage, source
+	mu.RUnlock()
+
+	format, err := src.Get(locale, helloKey)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(format, name), nil
+}
+
+// Hellos returns a greeting for each name in names, keyed by name. It
+// returns an error if names is empty or contains an empty string.
+func Hellos(names []string) (map[string]string, error) {
+	if len(names) == 0 {
+		return nil, errors.New("greetings: no names provided")
+	}
+
+	result := make(map[string]string, len(names))
+	for _, name := range names {
+		message, err := Hello(name)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = message
+	}
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+package greetings
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+)
+
+func TestHello(t *testing.T) {
+	name := "Gladys"
+	message, err := Hello(name)
+	if err != nil {
+		t.Fatalf("Hello(%q) returned an error: %v", name, err)
+	}
+
+	want := regexp.MustCompile(`\b` + name + `\b`)
+	if !want.MatchString(message) {
+		t.Errorf("Hello(%q) = %q, want match for %#q", name, message, want)
+	}
+}
+
+func TestHelloEmpty(t *testing.T) {
+	_, err := Hello("")
+	if err == nil {
+		t.Error("Hello(\"\") succeeded, want error")
+	}
+}
+
+func TestSetLanguage(t *testing.T) {
+	defer SetLanguage(English)
+
+	cases := []struct {
+		lang string
+		name string
+	}{
+		{English, "Gladys"},
+		{Spanish, "Gladys"},
+		{French, "Gladys"},
+		{Japanese, "世界"},
+	}
+	for _, c := range cases {
+		if err := SetLanguage(c.lang); err != nil {
+			t.Fatalf("SetLanguage(%q) returned an error: %v", c.lang, err)
+		}
+
+		message, err := Hello(c.name)
+		if err != nil {
+			t.Fatalf("Hello(%q) returned an error: %v", c.name, err)
+		}
+
+		ok := false
+		for _, format := range formats[c.lang] {
+			if fmt.Sprintf(format, c.name) == message {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			t.Errorf("Hello(%q) in %q = %q, want one of %v", c.name, c.lang, message, formats[c.lang])
+		}
+	}
+}
+
+func TestSetLanguageUnsupported(t *testing.T) {
+	if err := SetLanguage("xx"); err == nil {
+		t.Error("SetLanguage(\"xx\") succeeded, want error")
+	}
+}
+
+func TestRandomFormat(t *testing.T) {
+	SetRandSource(rand.NewSource(1))
+	defer SetRandSource(rand.NewSource(1))
+
+	format := RandomFormat()
+	ok := false
+	for _, f := range formats[English] {
+		if f == format {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		t.Errorf("RandomFormat() = %q, want one of %v", format, formats[English])
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	original := append([]string(nil), formats[English]...)
+	defer func() { formats[English] = original }()
+
+	if err := RegisterFormat("Yo, %v!"); err != nil {
+		t.Fatalf("RegisterFormat(valid) returned an error: %v", err)
+	}
+
+	cases := []string{
+		"No verb here",
+		"Two verbs %v and %v",
+		"Wrong verb %d",
+	}
+	for _, format := range cases {
+		if err := RegisterFormat(format); err == nil {
+			t.Errorf("RegisterFormat(%q) succeeded, want error", format)
+		}
+	}
+}
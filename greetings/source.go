@@ -0,0 +1,102 @@
+package greetings
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// helloKey is the message key Hello looks up on the configured source.
+const helloKey = "hello"
+
+// MessageSource supplies greeting format strings, keyed by language tag and
+// message key. It lets callers swap the package's message catalog without
+// recompiling, e.g. to load it from a file or the environment.
+type MessageSource interface {
+	// Get returns the format string for locale and key, or an error if no
+	// such message exists.
+	Get(locale, key string) (string, error)
+}
+
+// source is the MessageSource consulted by Hello. It defaults to an
+// in-memory source backed by formats and randomFormat.
+var source MessageSource = defaultSource{}
+
+// SetSource replaces the MessageSource consulted by Hello and Hellos.
+func SetSource(s MessageSource) {
+	mu.Lock()
+	defer mu.Unlock()
+	source = s
+}
+
+// defaultSource is the built-in MessageSource backed by the package's
+// in-memory formats table.
+type defaultSource struct{}
+
+func (defaultSource) Get(locale, key string) (string, error) {
+	if key != helloKey {
+		return "", fmt.Errorf("greetings: unknown message key %q", key)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return randomFormat(locale), nil
+}
+
+// fileSource is a MessageSource backed by a JSON file shaped as
+// {"<locale>": {"<key>": "<format>"}}.
+type fileSource struct {
+	messages map[string]map[string]string
+}
+
+// FileSource loads a MessageSource from the JSON file at path.
+func FileSource(path string) (MessageSource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("greetings: read message file: %w", err)
+	}
+
+	var messages map[string]map[string]string
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("greetings: parse message file: %w", err)
+	}
+
+	return fileSource{messages: messages}, nil
+}
+
+func (s fileSource) Get(locale, key string) (string, error) {
+	format, ok := s.messages[locale][key]
+	if !ok {
+		return "", fmt.Errorf("greetings: no message for locale %q key %q", locale, key)
+	}
+	if err := validateFormat(format); err != nil {
+		return "", err
+	}
+	return format, nil
+}
+
+// envSource is a MessageSource backed by environment variables named
+// <PREFIX>_<LOCALE>_<KEY>, e.g. GREETING_EN_HELLO.
+type envSource struct {
+	prefix string
+}
+
+// EnvSource returns a MessageSource that reads format strings from
+// environment variables named <PREFIX>_<LOCALE>_<KEY>; the whole name,
+// prefix included, is upper-cased.
+func EnvSource(prefix string) MessageSource {
+	return envSource{prefix: prefix}
+}
+
+func (s envSource) Get(locale, key string) (string, error) {
+	name := strings.ToUpper(strings.Join([]string{s.prefix, locale, key}, "_"))
+	format, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("greetings: environment variable %s not set", name)
+	}
+	if err := validateFormat(format); err != nil {
+		return "", err
+	}
+	return format, nil
+}
@@ -0,0 +1,89 @@
+package greetings
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSource(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "messages.json")
+	const contents = `{"en": {"hello": "Yo, %v!"}}`
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	src, err := FileSource(path)
+	if err != nil {
+		t.Fatalf("FileSource(%q) returned an error: %v", path, err)
+	}
+
+	format, err := src.Get(English, helloKey)
+	if err != nil {
+		t.Fatalf("Get(en, hello) returned an error: %v", err)
+	}
+	if want := "Yo, %v!"; format != want {
+		t.Errorf("Get(en, hello) = %q, want %q", format, want)
+	}
+
+	if _, err := src.Get(Japanese, helloKey); err == nil {
+		t.Error("Get(ja, hello) succeeded, want error for missing locale")
+	}
+}
+
+func TestFileSourceMissingFile(t *testing.T) {
+	if _, err := FileSource(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("FileSource(missing) succeeded, want error")
+	}
+}
+
+func TestFileSourceInvalidFormat(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "messages.json")
+	const contents = `{"en": {"hello": "Welcome!"}}`
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	src, err := FileSource(path)
+	if err != nil {
+		t.Fatalf("FileSource(%q) returned an error: %v", path, err)
+	}
+
+	if _, err := src.Get(English, helloKey); err == nil {
+		t.Error("Get(en, hello) succeeded for a format with no verb, want error")
+	}
+}
+
+func TestEnvSource(t *testing.T) {
+	t.Setenv("GREETING_EN_HELLO", "Howdy, %v!")
+	src := EnvSource("GREETING")
+
+	format, err := src.Get(English, helloKey)
+	if err != nil {
+		t.Fatalf("Get(en, hello) returned an error: %v", err)
+	}
+	if want := "Howdy, %v!"; format != want {
+		t.Errorf("Get(en, hello) = %q, want %q", format, want)
+	}
+
+	if _, err := src.Get(French, helloKey); err == nil {
+		t.Error("Get(fr, hello) succeeded, want error for unset variable")
+	}
+}
+
+func TestSetSource(t *testing.T) {
+	defer SetSource(defaultSource{})
+
+	SetSource(EnvSource("GREETING"))
+	t.Setenv("GREETING_EN_HELLO", "Sup, %v?")
+
+	message, err := Hello("Gladys")
+	if err != nil {
+		t.Fatalf("Hello returned an error: %v", err)
+	}
+	if want := "Sup, Gladys?"; message != want {
+		t.Errorf("Hello() = %q, want %q", message, want)
+	}
+}